@@ -0,0 +1,160 @@
+package pokecrypt
+
+import (
+	"encoding/binary"
+	stdhash "hash"
+)
+
+var _ stdhash.Hash64 = (*Digest)(nil)
+
+// Digest implements hash.Hash64, letting callers feed a payload through
+// Write in chunks instead of hashing it in one shot. This mirrors the
+// streaming construction in cespare/xxhash's Digest: an incomplete
+// 128-byte block is buffered in mem until a full block is available,
+// full blocks are absorbed as they arrive, and only Sum64 runs the
+// tail padding and finalization steps, leaving the Digest state
+// untouched so further Writes remain valid.
+type Digest struct {
+	version *Version
+	mem     [BlockSize]byte
+	n       int
+	state   Uint128
+	started bool
+}
+
+// NewSalt64 returns a Digest pre-seeded with the given 64-bit salt under v,
+// the big-endian-prefixed form Hash64Salt64 feeds into hash. It lets large
+// protobuf requests be hashed via Write without allocating a combined
+// salt+payload buffer up front.
+func (v *Version) NewSalt64(salt uint64) *Digest {
+	d := &Digest{version: v}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], salt)
+	d.Write(buf[:])
+	return d
+}
+
+// NewSalt64 returns a Digest pre-seeded with the given 64-bit salt under
+// DefaultVersion().
+func NewSalt64(salt uint64) *Digest {
+	return DefaultVersion().NewSalt64(salt)
+}
+
+// Write absorbs p into the digest, hashing any full 128-byte blocks it
+// completes and buffering the remainder. It never returns an error.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if d.n > 0 {
+		filled := copy(d.mem[d.n:], p)
+		d.n += filled
+		if d.n < BlockSize {
+			return n, nil
+		}
+		p = p[filled:]
+		d.n = 0
+		d.absorb(d.mem[:])
+	}
+
+	for len(p) >= BlockSize {
+		d.absorb(p[:BlockSize])
+		p = p[BlockSize:]
+	}
+
+	if len(p) > 0 {
+		d.n = copy(d.mem[:], p)
+	}
+
+	return n, nil
+}
+
+// absorb folds one full 128-byte block into the running state: the
+// first block absorbed is hashed directly and combined with magicRound,
+// every subsequent block is mixed in via hashMulAdd, matching hash's
+// treatment of the first block versus the rest.
+func (d *Digest) absorb(block []byte) {
+	h := d.version.hashBlock(block)
+	if !d.started {
+		d.state = h.Add(d.version.MagicRound)
+		d.started = true
+		return
+	}
+	d.state = hashMulAdd(d.state, d.version.MagicRound, h)
+}
+
+// Sum64 finalizes the digest over the bytes written so far and returns
+// the Niantic 64-bit hash. It does not mutate the Digest, so Write may
+// keep being called afterwards.
+func (d *Digest) Sum64() uint64 {
+	state := d.state
+	started := d.started
+	tailLen := d.n
+
+	if tailLen > 0 || !started {
+		padded := 16 * ((tailLen + 15) / 16)
+		tail := make([]byte, padded)
+		copy(tail, d.mem[:tailLen])
+
+		h := d.version.hashBlock(tail)
+		if !started {
+			state = h.Add(d.version.MagicRound)
+		} else {
+			state = hashMulAdd(state, d.version.MagicRound, h)
+		}
+	}
+
+	// Note: 0x7fffffffffffffffffffffffffffffff
+	u7fff := Uint128{^uint64(1 << 63), ^uint64(0)}
+
+	result := state.Add(Uint128{uint64(tailLen * 8), 0})
+	if result.Cmp(u7fff) >= 0 {
+		result = result.Add(Uint128{0, 1})
+	}
+	result = result.And(u7fff)
+
+	X := result[0] + (result[1] >> 32)
+	X = ((X + (X >> 32) + 1) >> 32) + result[0]
+	Y := (X << 32) + result[1]
+
+	A := X + d.version.MagicFinal[0]
+	if A < X {
+		A += 0x101
+	}
+
+	B := Y + d.version.MagicFinal[1]
+	if B < Y {
+		B += 0x101
+	}
+
+	result = mul64_128(A, B)
+	result = mul64_128(result[0], 0x101).Add(Uint128{0, result[1]})
+	result = mul64_128(result[0], 0x101).Add(Uint128{0, result[1]})
+
+	sum := result[1]
+	if result[0] != 0 {
+		sum += 0x101
+	}
+	if sum > 0xFFFFFFFFFFFFFEFE {
+		sum += 0x101
+	}
+
+	return sum
+}
+
+// Sum appends the big-endian Sum64 to b, satisfying hash.Hash.
+func (d *Digest) Sum(b []byte) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], d.Sum64())
+	return append(b, buf[:]...)
+}
+
+// Reset clears the digest back to its initial state, keeping its Version.
+func (d *Digest) Reset() {
+	*d = Digest{version: d.version}
+}
+
+// Size returns the number of bytes Sum appends: 8.
+func (d *Digest) Size() int { return 8 }
+
+// BlockSize returns the digest's internal block size.
+func (d *Digest) BlockSize() int { return BlockSize }
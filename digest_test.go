@@ -0,0 +1,76 @@
+package pokecrypt
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestDigestMatchesOneShot verifies Digest reproduces the same result as
+// the one-shot hash() path, regardless of how a payload is split across
+// Write calls, and that Sum64 can be called repeatedly / followed by
+// more Writes without corrupting the running state.
+func TestDigestMatchesOneShot(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	chunkSizes := []int{1, 3, 7, 16, 50, 127, 128, 200}
+
+	for _, size := range []int{0, 1, 15, 16, 17, 100, 127, 128, 129, 200, 256, 300, 1000, 4096} {
+		buf := make([]byte, size)
+		r.Read(buf)
+		salt := r.Uint64()
+
+		var saltedBuf [8]byte
+		for i := range saltedBuf {
+			saltedBuf[i] = byte(salt >> (8 * (7 - i)))
+		}
+		want := DefaultVersion().hash(append(saltedBuf[:], buf...))
+
+		d := NewSalt64(salt)
+		for data := buf; len(data) > 0; {
+			n := chunkSizes[len(data)%len(chunkSizes)]
+			if n > len(data) {
+				n = len(data)
+			}
+			d.Write(data[:n])
+			data = data[n:]
+		}
+
+		if got := d.Sum64(); got != want {
+			t.Fatalf("size=%d salt=%#x: Digest.Sum64() = %#x, want %#x", size, salt, got, want)
+		}
+
+		// Sum64 must not mutate the digest.
+		if got := d.Sum64(); got != want {
+			t.Fatalf("size=%d: second Sum64() call changed result", size)
+		}
+
+		// Further writes after Sum64 must still be valid.
+		d.Write([]byte("more data"))
+		if d.Sum64() == want && size > 0 {
+			t.Fatalf("size=%d: writing after Sum64 had no effect on the digest", size)
+		}
+	}
+}
+
+// TestHashRequestMatchesStream verifies the streamed HashRequest gives
+// the same answer as a hand-rolled concat-buffer computation.
+func TestHashRequestMatchesStream(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for _, size := range []int{0, 10, 128, 4096} {
+		authTicket := make([]byte, 24)
+		r.Read(authTicket)
+		request := make([]byte, size)
+		r.Read(request)
+
+		seed := Hash64(authTicket)
+		var saltedBuf [8]byte
+		for i := range saltedBuf {
+			saltedBuf[i] = byte(seed >> (8 * (7 - i)))
+		}
+		want := DefaultVersion().hash(append(saltedBuf[:], request...))
+
+		if got := HashRequest(authTicket, request); got != want {
+			t.Fatalf("size=%d: HashRequest() = %#x, want %#x", size, got, want)
+		}
+	}
+}
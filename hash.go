@@ -3,12 +3,11 @@ package pokecrypt
 import (
 	"encoding/binary"
 	"math"
-	"math/big"
+	"math/bits"
 )
 
 type Uint128 [2]uint64 // { high, low }
 
-const hashSeed uint32 = 0x61247FBF
 const BlockSize = 128
 
 /* IOS 1.13.x */
@@ -25,9 +24,11 @@ var magicTable = [16]uint64{
 var magicRound = Uint128{0x78F32468CD48D6DE, 0x14C983660183C0AE}
 var magicFinal = Uint128{0xBDB31B10864F3F87, 0x5B7E9E828A9B8ABD}
 
+const hashSeed uint32 = 0x61247FBF
+
 /************************************************************/
 
-func hash(input []byte) uint64 {
+func (v *Version) hash(input []byte) uint64 {
 	numBlocks := len(input) / BlockSize
 	tailLen := len(input) % BlockSize
 
@@ -37,22 +38,22 @@ func hash(input []byte) uint64 {
 
 	var hash Uint128
 	if numBlocks > 0 {
-		hash = hashBlock(input[0:BlockSize])
+		hash = v.hashBlock(input[0:BlockSize])
 	} else {
-		hash = hashBlock(tail)
+		hash = v.hashBlock(tail)
 	}
 
-	hash = hash.Add(magicRound)
+	hash = hash.Add(v.MagicRound)
 
 	if numBlocks > 0 {
 		for offset := BlockSize; numBlocks > 1; offset += BlockSize {
-			hash = hashMulAdd(hash, magicRound,
-				hashBlock(input[offset:offset+BlockSize]))
+			hash = hashMulAdd(hash, v.MagicRound,
+				v.hashBlock(input[offset:offset+BlockSize]))
 			numBlocks--
 		}
 
 		if tailLen > 0 {
-			hash = hashMulAdd(hash, magicRound, hashBlock(tail))
+			hash = hashMulAdd(hash, v.MagicRound, v.hashBlock(tail))
 		}
 	}
 
@@ -69,12 +70,12 @@ func hash(input []byte) uint64 {
 	X = ((X + (X >> 32) + 1) >> 32) + hash[0]
 	Y := (X << 32) + hash[1]
 
-	A := X + magicFinal[0]
+	A := X + v.MagicFinal[0]
 	if A < X {
 		A += 0x101
 	}
 
-	B := Y + magicFinal[1]
+	B := Y + v.MagicFinal[1]
 	if B < Y {
 		B += 0x101
 	}
@@ -95,16 +96,18 @@ func hash(input []byte) uint64 {
 }
 
 /* hash block of input */
-func hashBlock(block []byte) Uint128 {
+func (v *Version) hashBlock(block []byte) Uint128 {
 	hash := Uint128{0, 0}
 	magicIdx := 0
 	for offset := 0; offset < len(block); offset += 16 {
+		_ = block[offset+15] // bounds check hint
+
 		a := binary.LittleEndian.Uint64(block[offset:])
-		a += magicTable[magicIdx]
+		a += v.MagicTable[magicIdx]
 		magicIdx++
 
 		b := binary.LittleEndian.Uint64(block[offset+8:])
-		b += magicTable[magicIdx]
+		b += v.MagicTable[magicIdx]
 		magicIdx++
 
 		hash = hash.Add(mul64_128(a, b))
@@ -178,45 +181,42 @@ func (a Uint128) And(b Uint128) Uint128 {
 
 /* 64x64->128 multiply */
 func mul64_128(a, b uint64) Uint128 {
-	zprod := big.NewInt(0)
-	zprod.Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
-	zhi := big.NewInt(0)
-	zhi.Rsh(zprod, 64)
-	return Uint128{zhi.Uint64(), zprod.Uint64()}
+	hi, lo := bits.Mul64(a, b)
+	return Uint128{hi, lo}
 }
 
-// Hash32 hashes a buffer with the default seed and returns a uint32
-func Hash32(buffer []byte) uint32 {
-	return Hash32Salt(buffer, hashSeed)
+// Hash32 hashes a buffer with the Version's default seed and returns a uint32
+func (v *Version) Hash32(buffer []byte) uint32 {
+	return v.Hash32Salt(buffer, v.HashSeed)
 }
 
 // Hash32Salt hashes a buffer with the given seed and returns a uint32
-func Hash32Salt(buffer []byte, salt uint32) uint32 {
-	ret := Hash64Salt(buffer, salt)
+func (v *Version) Hash32Salt(buffer []byte, salt uint32) uint32 {
+	ret := v.Hash64Salt(buffer, salt)
 	return uint32(ret) ^ uint32(ret>>32)
 }
 
-// Hash64 hashes a buffer with the default seed and returns a uint64
-func Hash64(buffer []byte) uint64 {
-	return Hash64Salt(buffer, hashSeed)
+// Hash64 hashes a buffer with the Version's default seed and returns a uint64
+func (v *Version) Hash64(buffer []byte) uint64 {
+	return v.Hash64Salt(buffer, v.HashSeed)
 }
 
 // Hash64Salt hashes a buffer with the given uint32 seed and returns a uint64
-func Hash64Salt(buffer []byte, salt uint32) uint64 {
+func (v *Version) Hash64Salt(buffer []byte, salt uint32) uint64 {
 	newBuffer := make([]byte, len(buffer)+4)
 	binary.BigEndian.PutUint32(newBuffer, salt)
 	copy(newBuffer[4:], buffer)
 
-	return hash(newBuffer)
+	return v.hash(newBuffer)
 }
 
 // Hash64Salt64 hashes a buffer with the given uint64 seed and returns a uint64
-func Hash64Salt64(buffer []byte, salt uint64) uint64 {
+func (v *Version) Hash64Salt64(buffer []byte, salt uint64) uint64 {
 	newBuffer := make([]byte, len(buffer)+8)
 	binary.BigEndian.PutUint64(newBuffer, salt)
 	copy(newBuffer[8:], buffer)
 
-	return hash(newBuffer)
+	return v.hash(newBuffer)
 }
 
 func locationToBuffer(lat, lng, alt float64) []byte {
@@ -230,25 +230,66 @@ func locationToBuffer(lat, lng, alt float64) []byte {
 }
 
 // HashLocation1 hashes a location
-func HashLocation1(authTicket []byte, lat, lng, alt float64) uint32 {
-	seed := Hash32(authTicket)
+func (v *Version) HashLocation1(authTicket []byte, lat, lng, alt float64) uint32 {
+	seed := v.Hash32(authTicket)
 	payload := locationToBuffer(lat, lng, alt)
-	hash := Hash32Salt(payload, seed)
+	hash := v.Hash32Salt(payload, seed)
 	return hash
 }
 
 // HashLocation2 hashes a location too
-func HashLocation2(lat, lng, alt float64) uint32 {
+func (v *Version) HashLocation2(lat, lng, alt float64) uint32 {
 	payload := locationToBuffer(lat, lng, alt)
-	hash := Hash32(payload)
+	hash := v.Hash32(payload)
 	return hash
 }
 
 // HashRequest hashes a request
+func (v *Version) HashRequest(authTicket, request []byte) uint64 {
+	seed := v.Hash64(authTicket)
+	d := v.NewSalt64(seed)
+	d.Write(request)
+	return d.Sum64()
+}
+
+// Hash32 hashes a buffer with DefaultVersion's default seed and returns a uint32
+func Hash32(buffer []byte) uint32 {
+	return DefaultVersion().Hash32(buffer)
+}
+
+// Hash32Salt hashes a buffer with the given seed under DefaultVersion and returns a uint32
+func Hash32Salt(buffer []byte, salt uint32) uint32 {
+	return DefaultVersion().Hash32Salt(buffer, salt)
+}
+
+// Hash64 hashes a buffer with DefaultVersion's default seed and returns a uint64
+func Hash64(buffer []byte) uint64 {
+	return DefaultVersion().Hash64(buffer)
+}
+
+// Hash64Salt hashes a buffer with the given uint32 seed under DefaultVersion and returns a uint64
+func Hash64Salt(buffer []byte, salt uint32) uint64 {
+	return DefaultVersion().Hash64Salt(buffer, salt)
+}
+
+// Hash64Salt64 hashes a buffer with the given uint64 seed under DefaultVersion and returns a uint64
+func Hash64Salt64(buffer []byte, salt uint64) uint64 {
+	return DefaultVersion().Hash64Salt64(buffer, salt)
+}
+
+// HashLocation1 hashes a location under DefaultVersion
+func HashLocation1(authTicket []byte, lat, lng, alt float64) uint32 {
+	return DefaultVersion().HashLocation1(authTicket, lat, lng, alt)
+}
+
+// HashLocation2 hashes a location too, under DefaultVersion
+func HashLocation2(lat, lng, alt float64) uint32 {
+	return DefaultVersion().HashLocation2(lat, lng, alt)
+}
+
+// HashRequest hashes a request under DefaultVersion
 func HashRequest(authTicket, request []byte) uint64 {
-	seed := Hash64(authTicket)
-	hash := Hash64Salt64(request, seed)
-	return hash
+	return DefaultVersion().HashRequest(authTicket, request)
 }
 
 // Hash25 returns an int64 with something
@@ -0,0 +1,22 @@
+package pokecrypt
+
+import (
+	"strconv"
+	"testing"
+)
+
+func BenchmarkHash64(b *testing.B) {
+	for _, size := range []int{64, 256, 1024, 4096} {
+		buf := make([]byte, size)
+		for i := range buf {
+			buf[i] = byte(i)
+		}
+
+		b.Run(strconv.Itoa(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				Hash64(buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package pokecrypt
+
+// Signer bundles the location and request signing an outgoing RPC
+// needs for a single auth ticket. It caches Hash32(authTicket) and
+// Hash64(authTicket) instead of recomputing them on every call, and is
+// safe for concurrent use: once constructed, a Signer's fields never
+// change, so every signing method only touches its own local state.
+//
+// This intentionally does not implement Seal/Open for the client's
+// "unknown6" payload cipher: that's a separate, undocumented
+// XXTEA-family algorithm this package has no verified spec or known
+// test vectors for, and shipping a guessed construction would silently
+// produce ciphertext the real backend can't decrypt. Add it once the
+// actual algorithm is confirmed against real client/server traffic.
+type Signer struct {
+	version *Version
+	hash32  uint32
+	hash64  uint64
+}
+
+// NewSigner returns a Signer for authTicket under version. If version is
+// nil, DefaultVersion() is used.
+func NewSigner(authTicket []byte, version *Version) *Signer {
+	if version == nil {
+		version = DefaultVersion()
+	}
+	return &Signer{
+		version: version,
+		hash32:  version.Hash32(authTicket),
+		hash64:  version.Hash64(authTicket),
+	}
+}
+
+// SignLocation hashes a location the way the client attaches loc1/loc2
+// to every request: once salted with the cached auth-ticket hash, and
+// once with the Version's default HashSeed.
+func (s *Signer) SignLocation(lat, lng, alt float64) (loc1, loc2 uint32) {
+	payload := locationToBuffer(lat, lng, alt)
+	loc1 = s.version.Hash32Salt(payload, s.hash32)
+	loc2 = s.version.Hash32(payload)
+	return loc1, loc2
+}
+
+// SignRequests hashes each serialized request against the cached
+// auth-ticket seed, in order.
+func (s *Signer) SignRequests(requests [][]byte) []uint64 {
+	hashes := make([]uint64, len(requests))
+	for i, request := range requests {
+		d := s.version.NewSalt64(s.hash64)
+		d.Write(request)
+		hashes[i] = d.Sum64()
+	}
+	return hashes
+}
@@ -0,0 +1,70 @@
+package pokecrypt
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Version holds the per-client-version constants that Niantic rotates
+// with nearly every release: the magic multiplication table and
+// finalization constants consumed by the hash, plus the default seed.
+// Its methods mirror the package-level HashXxx helpers, which are thin
+// wrappers over DefaultVersion().
+type Version struct {
+	MagicTable [16]uint64
+	MagicRound Uint128
+	MagicFinal Uint128
+	HashSeed   uint32
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Version{}
+)
+
+// Register makes a Version available for later lookup by name. It is
+// safe to call concurrently with Lookup and other Registers.
+func Register(name string, v Version) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = v
+}
+
+// Lookup returns the Version registered under name, if any. It is safe
+// to call concurrently with Register.
+func Lookup(name string) (Version, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	v, ok := registry[name]
+	return v, ok
+}
+
+var defaultVersion atomic.Pointer[Version]
+
+// DefaultVersion returns the Version currently used by the
+// package-level HashXxx helpers. Switch it at runtime with SetDefault;
+// both are safe to call concurrently with each other and with the
+// HashXxx helpers.
+func DefaultVersion() *Version {
+	return defaultVersion.Load()
+}
+
+func init() {
+	Register("ios1.13.x", Version{
+		MagicTable: magicTable,
+		MagicRound: magicRound,
+		MagicFinal: magicFinal,
+		HashSeed:   hashSeed,
+	})
+	SetDefault("ios1.13.x")
+}
+
+// SetDefault switches the package-level HashXxx helpers to the Version
+// registered under name. It panics if name is not registered.
+func SetDefault(name string) {
+	v, ok := Lookup(name)
+	if !ok {
+		panic("pokecrypt: unknown version " + name)
+	}
+	defaultVersion.Store(&v)
+}
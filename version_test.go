@@ -0,0 +1,45 @@
+package pokecrypt
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestRegistryConcurrentAccess exercises Register/Lookup/SetDefault from
+// multiple goroutines at once; run with -race to catch regressions.
+func TestRegistryConcurrentAccess(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v := Version{HashSeed: uint32(i)}
+			Register("concurrent-test", v)
+			Lookup("concurrent-test")
+			SetDefault("ios1.13.x")
+		}(i)
+	}
+	wg.Wait()
+}
+
+// TestDefaultVersionConcurrentAccess exercises SetDefault racing against
+// the package-level HashXxx helpers reading DefaultVersion(); run with
+// -race to catch regressions.
+func TestDefaultVersionConcurrentAccess(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			SetDefault("ios1.13.x")
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Hash64([]byte("payload"))
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,26 @@
+// Package versions pre-registers the pokecrypt.Version constants known
+// for historical Niantic client releases. Importing it for its side
+// effects populates the pokecrypt package registry so callers can
+// pokecrypt.Lookup or pokecrypt.SetDefault by name without vendoring
+// the constants themselves.
+package versions
+
+import "github.com/ur0/pokecrypt-go"
+
+func init() {
+	pokecrypt.Register("ios1.13.x", pokecrypt.Version{
+		MagicTable: [16]uint64{
+			0x95C05F4D1512959E, 0xE4F3C46EEF0DCF07,
+			0x6238DC228F980AD2, 0x53F3E3BC49607092,
+			0x4E7BE7069078D625, 0x1016D709D1AD25FC,
+			0x044E89B8AC76E045, 0xE0B684DDA364BFA1,
+			0x90C533B835E89E5F, 0x3DAF462A74FA874F,
+			0xFEA54965DD3EF5A0, 0x287A5D7CCB31B970,
+			0xAE681046800752F8, 0x121C2D6EAF66EC6E,
+			0xEE8F8CA7E090FB20, 0xCE1AE25F48FE0A52,
+		},
+		MagicRound: pokecrypt.Uint128{0x78F32468CD48D6DE, 0x14C983660183C0AE},
+		MagicFinal: pokecrypt.Uint128{0xBDB31B10864F3F87, 0x5B7E9E828A9B8ABD},
+		HashSeed:   0x61247FBF,
+	})
+}